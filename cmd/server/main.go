@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/thedevsaddam/renderer"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/arminadm/Go-Server-todo-list/api"
+	"github.com/arminadm/Go-Server-todo-list/internal/auth"
+	"github.com/arminadm/Go-Server-todo-list/internal/config"
+	internalhttp "github.com/arminadm/Go-Server-todo-list/internal/http"
+	"github.com/arminadm/Go-Server-todo-list/internal/logging"
+	"github.com/arminadm/Go-Server-todo-list/internal/todo"
+	"github.com/arminadm/Go-Server-todo-list/internal/user"
+)
+
+const (
+	shutdownTimeout = 10 * time.Second
+	tokenTTL        = 24 * time.Hour
+)
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("invalid configuration", "error", err)
+		os.Exit(1)
+	}
+
+	logger := logging.New(cfg.LogLevel)
+	slog.SetDefault(logger)
+
+	rnd := renderer.New()
+
+	connectCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	client, err := mongo.Connect(connectCtx, options.Client().ApplyURI(cfg.MongoURI))
+	cancel()
+	if err != nil {
+		logger.Error("failed to connect to mongo", "error", err)
+		os.Exit(1)
+	}
+
+	pingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err = client.Ping(pingCtx, nil)
+	cancel()
+	if err != nil {
+		logger.Error("failed to ping mongo", "error", err)
+		os.Exit(1)
+	}
+
+	db := client.Database(cfg.MongoDatabase)
+
+	spec, err := api.Load()
+	if err != nil {
+		logger.Error("failed to load openapi spec", "error", err)
+		os.Exit(1)
+	}
+	specJSON, err := api.JSON(spec)
+	if err != nil {
+		logger.Error("failed to marshal openapi spec", "error", err)
+		os.Exit(1)
+	}
+	validateRequest, err := api.ValidationMiddleware(spec)
+	if err != nil {
+		logger.Error("failed to build openapi validation middleware", "error", err)
+		os.Exit(1)
+	}
+	docs := internalhttp.NewDocsHandler(specJSON)
+
+	tokens := auth.NewTokenManager(cfg.JWTSecret, tokenTTL)
+
+	userRepo := user.NewMongoRepository(db)
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	err = userRepo.EnsureIndexes(indexCtx)
+	cancel()
+	if err != nil {
+		logger.Error("failed to ensure user indexes", "error", err)
+		os.Exit(1)
+	}
+
+	userService := user.NewService(userRepo)
+	authHandler := internalhttp.NewAuthHandler(userService, tokens, rnd)
+
+	repo := todo.NewMongoRepository(db)
+	service := todo.NewService(repo)
+	handler := internalhttp.NewHandler(service, rnd)
+	health := internalhttp.NewHealthHandler(client)
+
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(logging.Middleware(logger))
+	r.Use(func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, cfg.RequestTimeout, "request timed out")
+	})
+	r.Use(validateRequest)
+	r.Get("/", homeHandler(rnd))
+	api.RegisterHealthRoutes(r, health)
+	r.Get("/openapi.json", docs.Spec)
+	r.Get("/docs", docs.UI)
+	api.RegisterAuthRoutes(r, authHandler)
+	r.Route("/todo", func(r chi.Router) {
+		r.Use(tokens.Middleware)
+		r.Mount("/", handler.Routes())
+	})
+
+	server := &http.Server{
+		Addr:         cfg.Port,
+		Handler:      r,
+		ReadTimeout:  60 * time.Second,
+		WriteTimeout: 60 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+
+	go func() {
+		logger.Info("listening", "port", cfg.Port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("server failed to listen and serve", "port", cfg.Port, "error", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	logger.Info("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("server shutdown error", "error", err)
+	}
+
+	if err := client.Disconnect(shutdownCtx); err != nil {
+		logger.Error("mongo disconnect error", "error", err)
+	}
+}
+
+func homeHandler(rnd *renderer.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := rnd.Template(w, http.StatusOK, []string{"static/index.tpl"}, nil); err != nil {
+			slog.Error("failed to render template", "error", err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Package auth issues and validates the JWTs used to authenticate todo
+// API requests, and exposes the chi middleware that enforces them.
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken is returned for a missing, malformed, or expired token.
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+type contextKey string
+
+const userIDContextKey contextKey = "userID"
+
+// Claims is the payload carried by tokens issued on register/login.
+type Claims struct {
+	UserID string `json:"uid"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager issues and validates HS256 JWTs signed with a shared
+// secret.
+type TokenManager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewTokenManager builds a TokenManager. Tokens it issues expire after ttl.
+func NewTokenManager(secret string, ttl time.Duration) *TokenManager {
+	return &TokenManager{secret: []byte(secret), ttl: ttl}
+}
+
+// Issue signs a new token for userID.
+func (m *TokenManager) Issue(userID string) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttl)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secret)
+}
+
+// Parse validates raw and returns the user id it was issued for.
+func (m *TokenManager) Parse(raw string) (string, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return "", ErrInvalidToken
+	}
+	return claims.UserID, nil
+}
+
+// Middleware rejects requests without a valid "Authorization: Bearer
+// <token>" header and injects the authenticated user id into the
+// request context.
+func (m *TokenManager) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		raw := strings.TrimPrefix(header, "Bearer ")
+		if raw == "" || raw == header {
+			http.Error(w, ErrInvalidToken.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		userID, err := m.Parse(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), userIDContextKey, userID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// UserIDFromContext returns the user id injected by Middleware, if any.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(userIDContextKey).(string)
+	return id, ok
+}
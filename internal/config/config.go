@@ -0,0 +1,67 @@
+// Package config loads and validates the server's runtime configuration
+// from environment variables.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config holds everything cmd/server needs to wire up the app.
+type Config struct {
+	Port           string
+	MongoURI       string
+	MongoDatabase  string
+	JWTSecret      string
+	LogLevel       string
+	RequestTimeout time.Duration
+}
+
+// Load reads Config from the environment, applying defaults and
+// validating required fields.
+func Load() (Config, error) {
+	cfg := Config{
+		Port:          getEnv("PORT", ":9000"),
+		MongoURI:      os.Getenv("MONGO_URI"),
+		MongoDatabase: getEnv("MONGO_DATABASE", "todo_app"),
+		JWTSecret:     os.Getenv("JWT_SECRET"),
+		LogLevel:      "info",
+	}
+
+	if debug, _ := parseBool(os.Getenv("DEBUG")); debug {
+		cfg.LogLevel = "debug"
+	}
+
+	timeout, err := time.ParseDuration(getEnv("REQUEST_TIMEOUT", "5s"))
+	if err != nil {
+		return Config{}, fmt.Errorf("REQUEST_TIMEOUT: %w", err)
+	}
+	cfg.RequestTimeout = timeout
+
+	if cfg.MongoURI == "" {
+		return Config{}, errors.New("MONGO_URI must be set")
+	}
+	if cfg.JWTSecret == "" {
+		return Config{}, errors.New("JWT_SECRET must be set")
+	}
+
+	return cfg, nil
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func parseBool(raw string) (bool, error) {
+	switch raw {
+	case "1", "true", "TRUE", "True":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/thedevsaddam/renderer"
+
+	"github.com/arminadm/Go-Server-todo-list/api"
+	"github.com/arminadm/Go-Server-todo-list/internal/auth"
+	"github.com/arminadm/Go-Server-todo-list/internal/logging"
+	"github.com/arminadm/Go-Server-todo-list/internal/user"
+)
+
+// AuthHandler exposes account registration and login over HTTP.
+type AuthHandler struct {
+	users  *user.Service
+	tokens *auth.TokenManager
+	rnd    *renderer.Render
+}
+
+// NewAuthHandler builds an AuthHandler issuing tokens through tokens.
+func NewAuthHandler(users *user.Service, tokens *auth.TokenManager, rnd *renderer.Render) *AuthHandler {
+	return &AuthHandler{users: users, tokens: tokens, rnd: rnd}
+}
+
+var _ api.AuthServerInterface = (*AuthHandler)(nil)
+
+// RegisterUser creates a new account and returns a JWT for it.
+func (h *AuthHandler) RegisterUser(w http.ResponseWriter, r *http.Request) {
+	var received api.Credentials
+	if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to decode user request",
+			"error":   err,
+		})
+		return
+	}
+
+	created, err := h.users.Register(r.Context(), received.Email, received.Password)
+	if err != nil {
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{"message": err.Error()})
+		return
+	}
+
+	token, err := h.tokens.Issue(created.ID)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to issue token", "error", err, "user_id", created.ID)
+		h.rnd.JSON(w, http.StatusInternalServerError, renderer.M{"message": "failed to issue token"})
+		return
+	}
+
+	h.rnd.JSON(w, http.StatusCreated, api.TokenResponse{Token: token})
+}
+
+// LoginUser authenticates an account and returns a JWT for it.
+func (h *AuthHandler) LoginUser(w http.ResponseWriter, r *http.Request) {
+	var received api.Credentials
+	if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to decode user request",
+			"error":   err,
+		})
+		return
+	}
+
+	authenticated, err := h.users.Authenticate(r.Context(), received.Email, received.Password)
+	if err != nil {
+		h.rnd.JSON(w, http.StatusUnauthorized, renderer.M{"message": err.Error()})
+		return
+	}
+
+	token, err := h.tokens.Issue(authenticated.ID)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to issue token", "error", err, "user_id", authenticated.ID)
+		h.rnd.JSON(w, http.StatusInternalServerError, renderer.M{"message": "failed to issue token"})
+		return
+	}
+
+	h.rnd.JSON(w, http.StatusOK, api.TokenResponse{Token: token})
+}
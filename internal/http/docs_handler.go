@@ -0,0 +1,47 @@
+package http
+
+import "net/http"
+
+// DocsHandler serves the raw OpenAPI document and a Swagger UI that
+// points at it.
+type DocsHandler struct {
+	specJSON []byte
+}
+
+// NewDocsHandler builds a DocsHandler serving the given OpenAPI document
+// JSON.
+func NewDocsHandler(specJSON []byte) *DocsHandler {
+	return &DocsHandler{specJSON: specJSON}
+}
+
+// Spec serves GET /openapi.json.
+func (h *DocsHandler) Spec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(h.specJSON)
+}
+
+// UI serves GET /docs, a Swagger UI pointed at /openapi.json.
+func (h *DocsHandler) UI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html")
+	w.Write(swaggerUIPage)
+}
+
+var swaggerUIPage = []byte(`<!DOCTYPE html>
+<html>
+<head>
+	<title>Go-Server-todo-list API docs</title>
+	<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist/swagger-ui.css">
+</head>
+<body>
+	<div id="swagger-ui"></div>
+	<script src="https://unpkg.com/swagger-ui-dist/swagger-ui-bundle.js"></script>
+	<script>
+		window.onload = () => {
+			window.ui = SwaggerUIBundle({
+				url: "/openapi.json",
+				dom_id: "#swagger-ui",
+			});
+		};
+	</script>
+</body>
+</html>`)
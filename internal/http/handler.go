@@ -0,0 +1,245 @@
+// Package http contains the chi handlers that expose an internal/todo
+// Service over HTTP. It knows about JSON and query parameters; it knows
+// nothing about Mongo.
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/thedevsaddam/renderer"
+
+	"github.com/arminadm/Go-Server-todo-list/api"
+	"github.com/arminadm/Go-Server-todo-list/internal/auth"
+	"github.com/arminadm/Go-Server-todo-list/internal/logging"
+	"github.com/arminadm/Go-Server-todo-list/internal/todo"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// Handler wires the /todo routes to a todo.Service.
+type Handler struct {
+	service *todo.Service
+	rnd     *renderer.Render
+}
+
+// NewHandler builds a Handler serving service through rnd.
+func NewHandler(service *todo.Service, rnd *renderer.Render) *Handler {
+	return &Handler{service: service, rnd: rnd}
+}
+
+var _ api.TodoServerInterface = (*Handler)(nil)
+
+// Routes returns the /todo sub-router.
+func (h *Handler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Group(func(r chi.Router) {
+		api.RegisterTodoRoutes(r, h)
+	})
+	return r
+}
+
+func (h *Handler) ListTodos(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		h.rnd.JSON(w, http.StatusUnauthorized, renderer.M{"message": "unauthorized"})
+		return
+	}
+
+	query := r.URL.Query()
+
+	limit := defaultLimit
+	if raw := query.Get("limit"); raw != "" {
+		if parsed, err := strconv.ParseInt(raw, 10, 64); err == nil && parsed > 0 {
+			limit = int(parsed)
+		}
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	offset := 0
+	if raw := query.Get("offset"); raw != "" {
+		if parsed, err := strconv.ParseInt(raw, 10, 64); err == nil && parsed >= 0 {
+			offset = int(parsed)
+		}
+	} else if raw := query.Get("page"); raw != "" {
+		if parsed, err := strconv.ParseInt(raw, 10, 64); err == nil && parsed > 0 {
+			offset = (int(parsed) - 1) * limit
+		}
+	}
+
+	filter := todo.Filter{OwnerID: ownerID, Query: query.Get("q")}
+	if raw := query.Get("completed"); raw != "" {
+		if parsed, err := strconv.ParseBool(raw); err == nil {
+			filter.Completed = &parsed
+		}
+	}
+
+	sort := todo.Sort{Field: "created_at"}
+	switch query.Get("sort") {
+	case "title":
+		sort.Field = "title"
+	case "-title":
+		sort.Field, sort.Desc = "title", true
+	case "-created_at":
+		sort.Desc = true
+	}
+
+	todos, total, err := h.service.List(r.Context(), filter, sort, todo.Page{Limit: limit, Offset: offset})
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to query todos", "error", err)
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to query through database",
+			"error":   err,
+		})
+		return
+	}
+
+	data := make([]api.Todo, len(todos))
+	for i, t := range todos {
+		data[i] = api.Todo{ID: t.ID, Title: t.Title, Completed: t.Completed, CreatedAt: t.CreatedAt}
+	}
+
+	h.rnd.JSON(w, http.StatusOK, api.TodoListResponse{
+		Data:   data,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+func (h *Handler) CreateTodo(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		h.rnd.JSON(w, http.StatusUnauthorized, renderer.M{"message": "unauthorized"})
+		return
+	}
+
+	var received api.CreateTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to decode user request",
+			"error":   err,
+		})
+		return
+	}
+
+	created, err := h.service.Create(r.Context(), ownerID, received.Title)
+	if err != nil {
+		if !errors.Is(err, todo.ErrTitleRequired) {
+			logging.FromContext(r.Context()).Error("failed to insert todo", "error", err)
+		}
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	h.rnd.JSON(w, http.StatusCreated, renderer.M{
+		"message": "new record created successfully",
+		"todo_id": created.ID,
+	})
+}
+
+func (h *Handler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		h.rnd.JSON(w, http.StatusUnauthorized, renderer.M{"message": "unauthorized"})
+		return
+	}
+
+	id := strings.TrimSpace(chi.URLParam(r, "id"))
+
+	var received api.UpdateTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to decode user request",
+			"error":   err,
+		})
+		return
+	}
+
+	matched, err := h.service.Update(r.Context(), ownerID, id, received.Title, received.Completed)
+	if err != nil {
+		if !errors.Is(err, todo.ErrTitleRequired) {
+			logging.FromContext(r.Context()).Error("failed to update todo", "error", err, "todo_id", id)
+		}
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	h.rnd.JSON(w, http.StatusCreated, renderer.M{
+		"message": strconv.FormatInt(matched, 10) + "record updated successfully",
+	})
+}
+
+func (h *Handler) PatchTodo(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		h.rnd.JSON(w, http.StatusUnauthorized, renderer.M{"message": "unauthorized"})
+		return
+	}
+
+	id := strings.TrimSpace(chi.URLParam(r, "id"))
+
+	var received api.PatchTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to decode user request",
+			"error":   err,
+		})
+		return
+	}
+
+	matched, err := h.service.Patch(r.Context(), ownerID, id, todo.Patch{
+		Title:     received.Title,
+		Completed: received.Completed,
+	})
+	if err != nil {
+		if !errors.Is(err, todo.ErrTitleRequired) && !errors.Is(err, todo.ErrNoFieldsToUpdate) {
+			logging.FromContext(r.Context()).Error("failed to patch todo", "error", err, "todo_id", id)
+		}
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	h.rnd.JSON(w, http.StatusOK, renderer.M{
+		"message": strconv.FormatInt(matched, 10) + "record updated successfully",
+	})
+}
+
+func (h *Handler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := auth.UserIDFromContext(r.Context())
+	if !ok {
+		h.rnd.JSON(w, http.StatusUnauthorized, renderer.M{"message": "unauthorized"})
+		return
+	}
+
+	id := strings.TrimSpace(chi.URLParam(r, "id"))
+
+	deleted, err := h.service.Delete(r.Context(), ownerID, id)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("failed to delete todo", "error", err, "todo_id", id)
+		h.rnd.JSON(w, http.StatusBadRequest, renderer.M{
+			"message": "failed to remove selected record",
+			"error":   err,
+		})
+		return
+	}
+
+	h.rnd.JSON(w, http.StatusOK, renderer.M{
+		"message": strconv.FormatInt(deleted, 10) + "records has been deleted successfully",
+	})
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/arminadm/Go-Server-todo-list/api"
+)
+
+// HealthHandler serves the liveness and readiness probes consumed by
+// Kubernetes (or docker-compose healthchecks).
+type HealthHandler struct {
+	client *mongo.Client
+}
+
+// NewHealthHandler builds a HealthHandler that pings client for readiness.
+func NewHealthHandler(client *mongo.Client) *HealthHandler {
+	return &HealthHandler{client: client}
+}
+
+var _ api.HealthServerInterface = (*HealthHandler)(nil)
+
+// Healthz reports that the process is up, without touching Mongo.
+func (h *HealthHandler) Healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// Readyz reports whether the app can currently serve traffic, i.e.
+// whether Mongo is reachable.
+func (h *HealthHandler) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+	defer cancel()
+
+	if err := h.client.Ping(ctx, nil); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,117 @@
+package todo
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemoryRepository is an in-memory Repository. It exists so the Service
+// layer can be unit tested without a running MongoDB instance.
+type MemoryRepository struct {
+	mu    sync.Mutex
+	items map[string]Todo
+}
+
+// NewMemoryRepository returns an empty MemoryRepository.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{items: make(map[string]Todo)}
+}
+
+func (r *MemoryRepository) Find(ctx context.Context, filter Filter, s Sort, page Page) ([]Todo, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matched := make([]Todo, 0, len(r.items))
+	for _, t := range r.items {
+		if t.OwnerID != filter.OwnerID {
+			continue
+		}
+		if filter.Completed != nil && t.Completed != *filter.Completed {
+			continue
+		}
+		if filter.Query != "" && !strings.Contains(strings.ToLower(t.Title), strings.ToLower(filter.Query)) {
+			continue
+		}
+		matched = append(matched, t)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		var less bool
+		switch s.Field {
+		case "title":
+			less = matched[i].Title < matched[j].Title
+		default:
+			less = matched[i].CreatedAt.Before(matched[j].CreatedAt)
+		}
+		if s.Desc {
+			return !less
+		}
+		return less
+	})
+
+	total := int64(len(matched))
+
+	start := page.Offset
+	if start > len(matched) {
+		start = len(matched)
+	}
+	end := len(matched)
+	if page.Limit > 0 && start+page.Limit < end {
+		end = start + page.Limit
+	}
+
+	return matched[start:end], total, nil
+}
+
+func (r *MemoryRepository) Create(ctx context.Context, t Todo) (Todo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items[t.ID] = t
+	return t, nil
+}
+
+func (r *MemoryRepository) Update(ctx context.Context, ownerID, id string, t Todo) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.items[id]
+	if !ok || existing.OwnerID != ownerID {
+		return 0, nil
+	}
+	existing.Title = t.Title
+	existing.Completed = t.Completed
+	r.items[id] = existing
+	return 1, nil
+}
+
+func (r *MemoryRepository) Patch(ctx context.Context, ownerID, id string, patch Patch) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.items[id]
+	if !ok || existing.OwnerID != ownerID {
+		return 0, nil
+	}
+	if patch.Title != nil {
+		existing.Title = *patch.Title
+	}
+	if patch.Completed != nil {
+		existing.Completed = *patch.Completed
+	}
+	r.items[id] = existing
+	return 1, nil
+}
+
+func (r *MemoryRepository) Delete(ctx context.Context, ownerID, id string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.items[id]
+	if !ok || existing.OwnerID != ownerID {
+		return 0, nil
+	}
+	delete(r.items, id)
+	return 1, nil
+}
@@ -0,0 +1,124 @@
+package todo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoDoc mirrors Todo for BSON (de)serialization.
+type mongoDoc struct {
+	ID        string    `bson:"_id,omitempty"`
+	OwnerID   string    `bson:"owner_id"`
+	Title     string    `bson:"title"`
+	Completed bool      `bson:"completed"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MongoRepository is a Repository backed by a MongoDB collection.
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoRepository returns a MongoRepository using the "tasks"
+// collection of db.
+func NewMongoRepository(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{collection: db.Collection("tasks")}
+}
+
+func (r *MongoRepository) Find(ctx context.Context, filter Filter, sort Sort, page Page) ([]Todo, int64, error) {
+	q := bson.M{"owner_id": filter.OwnerID}
+	if filter.Completed != nil {
+		q["completed"] = *filter.Completed
+	}
+	if filter.Query != "" {
+		q["title"] = bson.M{"$regex": filter.Query, "$options": "i"}
+	}
+
+	total, err := r.collection.CountDocuments(ctx, q)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sortField := sort.Field
+	if sortField == "" {
+		sortField = "created_at"
+	}
+	sortOrder := 1
+	if sort.Desc {
+		sortOrder = -1
+	}
+
+	opts := options.Find().
+		SetLimit(int64(page.Limit)).
+		SetSkip(int64(page.Offset)).
+		SetSort(bson.D{{Key: sortField, Value: sortOrder}})
+
+	cursor, err := r.collection.Find(ctx, q, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var docs []mongoDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, 0, err
+	}
+
+	todos := make([]Todo, 0, len(docs))
+	for _, d := range docs {
+		todos = append(todos, Todo(d))
+	}
+	return todos, total, nil
+}
+
+func (r *MongoRepository) Create(ctx context.Context, t Todo) (Todo, error) {
+	if _, err := r.collection.InsertOne(ctx, mongoDoc(t)); err != nil {
+		return Todo{}, err
+	}
+	return t, nil
+}
+
+func (r *MongoRepository) Update(ctx context.Context, ownerID, id string, t Todo) (int64, error) {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id, "owner_id": ownerID},
+		bson.M{"$set": bson.M{
+			"title":     t.Title,
+			"completed": t.Completed,
+		}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.MatchedCount, nil
+}
+
+func (r *MongoRepository) Patch(ctx context.Context, ownerID, id string, patch Patch) (int64, error) {
+	set := bson.M{}
+	if patch.Title != nil {
+		set["title"] = *patch.Title
+	}
+	if patch.Completed != nil {
+		set["completed"] = *patch.Completed
+	}
+	if len(set) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "owner_id": ownerID}, bson.M{"$set": set})
+	if err != nil {
+		return 0, err
+	}
+	return result.MatchedCount, nil
+}
+
+func (r *MongoRepository) Delete(ctx context.Context, ownerID, id string) (int64, error) {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id, "owner_id": ownerID})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
@@ -0,0 +1,75 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTitleRequired is returned when a create/update/patch would leave a
+// Todo with an empty title.
+var ErrTitleRequired = errors.New("title is required")
+
+// ErrNoFieldsToUpdate is returned when a patch sets no fields.
+var ErrNoFieldsToUpdate = errors.New("no fields to update")
+
+// Service implements the todo business rules on top of a Repository.
+type Service struct {
+	repo Repository
+}
+
+// NewService builds a Service backed by repo.
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// List returns a page of Todos matching filter, ordered by sort.
+func (s *Service) List(ctx context.Context, filter Filter, sort Sort, page Page) ([]Todo, int64, error) {
+	return s.repo.Find(ctx, filter, sort, page)
+}
+
+// Create stores a new Todo owned by ownerID with the given title.
+func (s *Service) Create(ctx context.Context, ownerID, title string) (Todo, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return Todo{}, ErrTitleRequired
+	}
+
+	t := Todo{
+		ID:        uuid.New().String(),
+		OwnerID:   ownerID,
+		Title:     title,
+		Completed: false,
+		CreatedAt: time.Now(),
+	}
+	return s.repo.Create(ctx, t)
+}
+
+// Update replaces the title and completed state of an existing Todo
+// owned by ownerID.
+func (s *Service) Update(ctx context.Context, ownerID, id, title string, completed bool) (int64, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return 0, ErrTitleRequired
+	}
+	return s.repo.Update(ctx, ownerID, id, Todo{Title: title, Completed: completed})
+}
+
+// Patch applies a partial update to an existing Todo owned by ownerID.
+func (s *Service) Patch(ctx context.Context, ownerID, id string, patch Patch) (int64, error) {
+	if patch.Title == nil && patch.Completed == nil {
+		return 0, ErrNoFieldsToUpdate
+	}
+	if patch.Title != nil && strings.TrimSpace(*patch.Title) == "" {
+		return 0, ErrTitleRequired
+	}
+	return s.repo.Patch(ctx, ownerID, id, patch)
+}
+
+// Delete removes a Todo owned by ownerID.
+func (s *Service) Delete(ctx context.Context, ownerID, id string) (int64, error) {
+	return s.repo.Delete(ctx, ownerID, id)
+}
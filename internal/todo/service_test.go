@@ -0,0 +1,99 @@
+package todo
+
+import (
+	"context"
+	"testing"
+)
+
+const testOwnerID = "owner-1"
+
+func TestServiceCreateRequiresTitle(t *testing.T) {
+	svc := NewService(NewMemoryRepository())
+
+	if _, err := svc.Create(context.Background(), testOwnerID, "   "); err != ErrTitleRequired {
+		t.Fatalf("expected ErrTitleRequired, got %v", err)
+	}
+}
+
+func TestServiceCreateAndList(t *testing.T) {
+	svc := NewService(NewMemoryRepository())
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, testOwnerID, "write tests"); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	todos, total, err := svc.List(ctx, Filter{OwnerID: testOwnerID}, Sort{}, Page{Limit: 10})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if total != 1 || len(todos) != 1 {
+		t.Fatalf("expected 1 todo, got total=%d len=%d", total, len(todos))
+	}
+	if todos[0].Title != "write tests" {
+		t.Fatalf("unexpected title: %q", todos[0].Title)
+	}
+}
+
+func TestServiceListScopesToOwner(t *testing.T) {
+	svc := NewService(NewMemoryRepository())
+	ctx := context.Background()
+
+	if _, err := svc.Create(ctx, testOwnerID, "mine"); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if _, err := svc.Create(ctx, "someone-else", "theirs"); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	todos, total, err := svc.List(ctx, Filter{OwnerID: testOwnerID}, Sort{}, Page{Limit: 10})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if total != 1 || len(todos) != 1 || todos[0].Title != "mine" {
+		t.Fatalf("expected only the owner's todo, got %+v (total=%d)", todos, total)
+	}
+}
+
+func TestServicePatchRejectsEmptyTitle(t *testing.T) {
+	svc := NewService(NewMemoryRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, testOwnerID, "original")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	empty := "  "
+	if _, err := svc.Patch(ctx, testOwnerID, created.ID, Patch{Title: &empty}); err != ErrTitleRequired {
+		t.Fatalf("expected ErrTitleRequired, got %v", err)
+	}
+
+	completed := true
+	matched, err := svc.Patch(ctx, testOwnerID, created.ID, Patch{Completed: &completed})
+	if err != nil {
+		t.Fatalf("Patch returned error: %v", err)
+	}
+	if matched != 1 {
+		t.Fatalf("expected 1 match, got %d", matched)
+	}
+}
+
+func TestServicePatchDoesNotAffectOtherOwners(t *testing.T) {
+	svc := NewService(NewMemoryRepository())
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, testOwnerID, "original")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	completed := true
+	matched, err := svc.Patch(ctx, "someone-else", created.ID, Patch{Completed: &completed})
+	if err != nil {
+		t.Fatalf("Patch returned error: %v", err)
+	}
+	if matched != 0 {
+		t.Fatalf("expected 0 matches for a non-owner, got %d", matched)
+	}
+}
@@ -0,0 +1,56 @@
+// Package todo holds the domain model for tasks, independent of how they
+// are transported (HTTP) or stored (Mongo, memory, ...).
+package todo
+
+import (
+	"context"
+	"time"
+)
+
+// Todo is a single task, owned by exactly one user. The JSON tags match
+// the original API's wire format; OwnerID is an internal scoping detail
+// and must never be serialized into a response.
+type Todo struct {
+	ID        string    `json:"id"`
+	OwnerID   string    `json:"-"`
+	Title     string    `json:"title"`
+	Completed bool      `json:"completed"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Filter narrows down which Todos a query should return. OwnerID is
+// always required so a Repository never leaks another user's tasks.
+type Filter struct {
+	OwnerID   string
+	Completed *bool
+	Query     string
+}
+
+// Sort describes how results should be ordered.
+type Sort struct {
+	Field string // "created_at" or "title"
+	Desc  bool
+}
+
+// Page bounds how many results are returned and where the window starts.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// Patch is a partial update to an existing Todo; nil fields are left
+// untouched by a Repository's Patch implementation.
+type Patch struct {
+	Title     *string
+	Completed *bool
+}
+
+// Repository stores and retrieves Todos. Update, Patch, and Delete are
+// scoped to ownerID so a caller can never affect another user's task.
+type Repository interface {
+	Find(ctx context.Context, filter Filter, sort Sort, page Page) ([]Todo, int64, error)
+	Create(ctx context.Context, t Todo) (Todo, error)
+	Update(ctx context.Context, ownerID, id string, t Todo) (matched int64, err error)
+	Patch(ctx context.Context, ownerID, id string, patch Patch) (matched int64, err error)
+	Delete(ctx context.Context, ownerID, id string) (deleted int64, err error)
+}
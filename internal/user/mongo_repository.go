@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoDoc mirrors User for BSON (de)serialization.
+type mongoDoc struct {
+	ID           string `bson:"_id,omitempty"`
+	Email        string `bson:"email"`
+	PasswordHash string `bson:"password_hash"`
+}
+
+// MongoRepository is a Repository backed by a MongoDB collection.
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMongoRepository returns a MongoRepository using the "users"
+// collection of db.
+func NewMongoRepository(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{collection: db.Collection("users")}
+}
+
+// EnsureIndexes creates the unique index on email that Create relies on
+// to reject duplicate registrations, even when two requests race past
+// the service layer's FindByEmail check. It's idempotent and meant to
+// be called once at startup.
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "email", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
+func (r *MongoRepository) Create(ctx context.Context, u User) (User, error) {
+	if _, err := r.collection.InsertOne(ctx, mongoDoc(u)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return User{}, ErrEmailTaken
+		}
+		return User{}, err
+	}
+	return u, nil
+}
+
+func (r *MongoRepository) FindByEmail(ctx context.Context, email string) (User, error) {
+	var doc mongoDoc
+	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return User{}, ErrNotFound
+	}
+	if err != nil {
+		return User{}, err
+	}
+	return User(doc), nil
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Service implements registration and authentication on top of a
+// Repository.
+type Service struct {
+	repo Repository
+}
+
+// NewService builds a Service backed by repo.
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Register creates a new account, rejecting blank or already-taken
+// emails.
+func (s *Service) Register(ctx context.Context, email, password string) (User, error) {
+	email = strings.TrimSpace(strings.ToLower(email))
+	if email == "" {
+		return User{}, ErrEmailRequired
+	}
+	if password == "" {
+		return User{}, ErrPasswordRequired
+	}
+
+	_, err := s.repo.FindByEmail(ctx, email)
+	if err == nil {
+		return User{}, ErrEmailTaken
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return User{}, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return User{}, err
+	}
+
+	return s.repo.Create(ctx, User{
+		ID:           uuid.New().String(),
+		Email:        email,
+		PasswordHash: string(hash),
+	})
+}
+
+// Authenticate verifies email/password and returns the matching User.
+func (s *Service) Authenticate(ctx context.Context, email, password string) (User, error) {
+	email = strings.TrimSpace(strings.ToLower(email))
+
+	u, err := s.repo.FindByEmail(ctx, email)
+	if err != nil {
+		return User{}, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+		return User{}, ErrInvalidCredentials
+	}
+
+	return u, nil
+}
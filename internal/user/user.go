@@ -0,0 +1,31 @@
+// Package user handles account registration and authentication,
+// independent of how credentials are checked over HTTP.
+package user
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNotFound is returned by a Repository when no user matches.
+	ErrNotFound = errors.New("user not found")
+
+	ErrEmailRequired      = errors.New("email is required")
+	ErrPasswordRequired   = errors.New("password is required")
+	ErrEmailTaken         = errors.New("email already registered")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+)
+
+// User is an account that owns todos.
+type User struct {
+	ID           string
+	Email        string
+	PasswordHash string
+}
+
+// Repository stores and retrieves Users.
+type Repository interface {
+	Create(ctx context.Context, u User) (User, error)
+	FindByEmail(ctx context.Context, email string) (User, error)
+}